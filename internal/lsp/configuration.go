@@ -0,0 +1,55 @@
+package lsp
+
+import (
+	"net/url"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+)
+
+// initializationOptions is the shape this server expects in
+// InitializeParams.InitializationOptions and in
+// workspace/didChangeConfiguration notifications.
+type initializationOptions struct {
+	// ScriptRoots are searched in order when resolving an #include/exec
+	// reference, e.g. ["maps", "global"].
+	ScriptRoots []string `json:"scriptRoots"`
+}
+
+// applyInitializationOptions decodes raw (InitializeParams.InitializationOptions
+// or a didChangeConfiguration settings payload) into s.config, leaving the
+// existing configuration untouched if raw is absent or malformed.
+func (s *Server) applyInitializationOptions(raw any) {
+	var opts initializationOptions
+	if decodeData(raw, &opts) && opts.ScriptRoots != nil {
+		s.config.ScriptRoots = opts.ScriptRoots
+	}
+}
+
+// workspaceRootFromInitialize derives an absolute filesystem path from
+// whichever of RootURI/RootPath/WorkspaceFolders the client sent; RootURI
+// takes priority as the field every modern client populates.
+func workspaceRootFromInitialize(params *protocol.InitializeParams) string {
+	if params.RootURI != nil {
+		if u, err := url.Parse(*params.RootURI); err == nil {
+			return u.Path
+		}
+	}
+	if params.RootPath != nil {
+		return *params.RootPath
+	}
+	if len(params.WorkspaceFolders) > 0 {
+		if u, err := url.Parse(params.WorkspaceFolders[0].URI); err == nil {
+			return u.Path
+		}
+	}
+	return ""
+}
+
+// DidChangeConfiguration implements workspace/didChangeConfiguration, so a
+// client that reconfigures ScriptRoots after startup (rather than only at
+// initialize) is picked up without a restart.
+func (s *Server) DidChangeConfiguration(context *glsp.Context, params *protocol.DidChangeConfigurationParams) error {
+	s.applyInitializationOptions(params.Settings)
+	return nil
+}
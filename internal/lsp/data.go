@@ -0,0 +1,19 @@
+package lsp
+
+import "encoding/json"
+
+// decodeData recovers a typed struct previously stashed on a protocol.Data
+// field. glsp round-trips Data through JSON-RPC, so by the time it comes
+// back from the client it's a map[string]interface{}, not the original Go
+// struct - a bare type assertion always fails. Re-marshal through JSON
+// instead.
+func decodeData(raw any, out any) bool {
+	if raw == nil {
+		return false
+	}
+	bytes, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(bytes, out) == nil
+}
@@ -0,0 +1,100 @@
+package lsp
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/Feho/mohaa-lsp/internal/morpheus"
+)
+
+// linkData is stashed on a DocumentLink's Data field so DocumentLinkResolve
+// can re-resolve it without re-walking the tree.
+type linkData struct {
+	URI  string `json:"uri"`
+	Path string `json:"path"`
+}
+
+// DocumentLink implements textDocument/documentLink for Morpheus scripts: it
+// walks the parse tree for #include, exec and thread level_script
+// references and turns each into a DocumentLink. Targets are resolved
+// eagerly when the document holds at most lazyResolveThreshold references;
+// otherwise resolution is deferred to DocumentLinkResolve.
+func (s *Server) DocumentLink(context *glsp.Context, params *protocol.DocumentLinkParams) ([]protocol.DocumentLink, error) {
+	doc, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil, nil
+	}
+
+	refs, err := morpheus.FindReferences(doc)
+	if err != nil {
+		return nil, fmt.Errorf("walking %s for links: %w", params.TextDocument.URI, err)
+	}
+
+	roots := s.config.ScriptRoots
+	deferResolution := len(refs) > lazyResolveThreshold
+
+	links := make([]protocol.DocumentLink, 0, len(refs))
+	for _, ref := range refs {
+		link := protocol.DocumentLink{
+			Range: byteRangeToRange(doc, ref.StartByte, ref.EndByte),
+			Tooltip: strPtr(ref.Path),
+		}
+		if deferResolution {
+			link.Data = linkData{URI: params.TextDocument.URI, Path: ref.Path}
+		} else if resolved, ok := morpheus.Resolve(ref.Path, roots, s.fileExists); ok {
+			link.Target = strPtr(s.pathToFileURI(resolved))
+			link.Tooltip = strPtr(resolved)
+		}
+		links = append(links, link)
+	}
+	return links, nil
+}
+
+// lazyResolveThreshold is the number of links above which we skip eager
+// resolution and let the client call documentLink/resolve on demand, so a
+// single large script doesn't stall textDocument/documentLink.
+const lazyResolveThreshold = 64
+
+// DocumentLinkResolve implements documentLink/resolve, filling in Target for
+// a link that DocumentLink returned unresolved.
+func (s *Server) DocumentLinkResolve(context *glsp.Context, params *protocol.DocumentLink) (*protocol.DocumentLink, error) {
+	var data linkData
+	if !decodeData(params.Data, &data) {
+		return params, nil
+	}
+	if resolved, ok := morpheus.Resolve(data.Path, s.config.ScriptRoots, s.fileExists); ok {
+		params.Target = strPtr(s.pathToFileURI(resolved))
+		params.Tooltip = strPtr(resolved)
+	}
+	return params, nil
+}
+
+// fileExists reports whether path exists under the workspace root, relative
+// to which every ScriptRoot is interpreted.
+func (s *Server) fileExists(path string) bool {
+	_, err := os.Stat(filepath.Join(s.config.WorkspaceRoot, path))
+	return err == nil
+}
+
+// pathToFileURI turns a workspace-relative path into an absolute file://
+// URI. morpheus.Resolve only ever returns workspace-relative paths, so this
+// always joins against WorkspaceRoot rather than trusting p to be absolute.
+func (s *Server) pathToFileURI(p string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.Join(s.config.WorkspaceRoot, p)}).String()
+}
+
+func strPtr(s string) *string { return &s }
+
+func byteRangeToRange(doc *morpheus.Document, start, end uint) protocol.Range {
+	startLine, startChar := doc.UTF16Position(start)
+	endLine, endChar := doc.UTF16Position(end)
+	return protocol.Range{
+		Start: protocol.Position{Line: startLine, Character: startChar},
+		End:   protocol.Position{Line: endLine, Character: endChar},
+	}
+}
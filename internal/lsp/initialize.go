@@ -0,0 +1,28 @@
+package lsp
+
+import (
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+)
+
+// Initialize implements the LSP initialize request, advertising the
+// capabilities this server actually implements.
+func (s *Server) Initialize(context *glsp.Context, params *protocol.InitializeParams) (*protocol.InitializeResult, error) {
+	s.config.WorkspaceRoot = workspaceRootFromInitialize(params)
+	s.applyInitializationOptions(params.InitializationOptions)
+
+	full := protocol.TextDocumentSyncKindFull
+	trueVal := true
+	return &protocol.InitializeResult{
+		Capabilities: protocol.ServerCapabilities{
+			TextDocumentSync: protocol.TextDocumentSyncOptions{
+				OpenClose: &trueVal,
+				Change:    &full,
+			},
+			DocumentLinkProvider: &protocol.DocumentLinkOptions{
+				ResolveProvider: &trueVal,
+			},
+			CodeActionProvider: &trueVal,
+		},
+	}, nil
+}
@@ -0,0 +1,34 @@
+// Package lsp wires the Morpheus language providers (parsing, links,
+// diagnostics, code actions) to the glsp protocol handlers.
+package lsp
+
+import (
+	"github.com/Feho/mohaa-lsp/internal/morpheus"
+)
+
+// Config holds the workspace settings the client sends in
+// initializationOptions / workspace/didChangeConfiguration.
+type Config struct {
+	// WorkspaceRoot is the absolute filesystem path every ScriptRoot is
+	// resolved relative to.
+	WorkspaceRoot string
+	// ScriptRoots are searched in order when resolving an #include/exec
+	// reference, e.g. []string{"maps", "global"}.
+	ScriptRoots []string
+}
+
+// Server holds the LSP session state: open documents and the active
+// workspace configuration.
+type Server struct {
+	config    Config
+	documents map[string]*morpheus.Document
+}
+
+// NewServer creates a Server with no open documents and a default (empty)
+// configuration; the client is expected to send its real configuration
+// during initialize.
+func NewServer() *Server {
+	return &Server{
+		documents: make(map[string]*morpheus.Document),
+	}
+}
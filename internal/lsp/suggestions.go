@@ -0,0 +1,114 @@
+package lsp
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/Feho/mohaa-lsp/internal/morpheus"
+)
+
+// suggestionSource identifies this package as the diagnostic source so
+// clients can filter or group "did you mean" findings separately from
+// parse errors.
+const suggestionSource = "mohaa-lsp(suggest)"
+
+// unknownIdentifierData is stashed on the diagnostic so CodeAction can
+// recover the unresolved name and its range; CodeAction re-runs Suggest on
+// that name to rebuild the candidates.
+type unknownIdentifierData struct {
+	URI       string `json:"uri"`
+	Name      string `json:"name"`
+	StartByte uint   `json:"startByte"`
+	EndByte   uint   `json:"endByte"`
+}
+
+// UnresolvedIdentifierDiagnostics builds "did you mean" diagnostics for
+// every identifier in doc that failed name resolution, ranking candidates
+// drawn from the document's own symbols and the builtins catalogue. An
+// unresolved identifier with no candidate close enough gets no diagnostic.
+func UnresolvedIdentifierDiagnostics(doc *morpheus.Document) ([]protocol.Diagnostic, error) {
+	symbols, err := morpheus.DocumentSymbolNames(doc)
+	if err != nil {
+		return nil, fmt.Errorf("collecting symbols for %s: %w", doc.URI, err)
+	}
+	candidates := append(append([]string{}, symbols...), morpheus.Builtins...)
+
+	unresolved, err := morpheus.FindUnresolvedIdentifiers(doc, candidates)
+	if err != nil {
+		return nil, fmt.Errorf("finding unresolved identifiers in %s: %w", doc.URI, err)
+	}
+
+	var diagnostics []protocol.Diagnostic
+	for _, id := range unresolved {
+		suggestions := morpheus.Suggest(id.Name, candidates)
+		if len(suggestions) == 0 {
+			continue
+		}
+		names := make([]string, len(suggestions))
+		for i, s := range suggestions {
+			names[i] = s.Name
+		}
+		diagnostics = append(diagnostics, protocol.Diagnostic{
+			Range:    byteRangeToRange(doc, id.StartByte, id.EndByte),
+			Severity: severityPtr(protocol.DiagnosticSeverityWarning),
+			Source:   strPtr(suggestionSource),
+			Message:  fmt.Sprintf("unknown identifier %q - did you mean %s?", id.Name, strings.Join(names, ", ")),
+			Data: unknownIdentifierData{
+				URI:       doc.URI,
+				Name:      id.Name,
+				StartByte: id.StartByte,
+				EndByte:   id.EndByte,
+			},
+		})
+	}
+	return diagnostics, nil
+}
+
+// CodeAction implements textDocument/codeAction, turning each "did you mean"
+// diagnostic in the request range into one quickfix per suggested name.
+func (s *Server) CodeAction(context *glsp.Context, params *protocol.CodeActionParams) ([]protocol.CodeAction, error) {
+	doc, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		return nil, nil
+	}
+
+	var actions []protocol.CodeAction
+	for _, diagnostic := range params.Context.Diagnostics {
+		var data unknownIdentifierData
+		if !decodeData(diagnostic.Data, &data) {
+			continue
+		}
+		symbols, err := morpheus.DocumentSymbolNames(doc)
+		if err != nil {
+			return nil, fmt.Errorf("collecting symbols for %s: %w", doc.URI, err)
+		}
+		candidates := append(append([]string{}, symbols...), morpheus.Builtins...)
+		for _, suggestion := range morpheus.Suggest(data.Name, candidates) {
+			actions = append(actions, quickfixAction(doc, data, suggestion, diagnostic))
+		}
+	}
+	return actions, nil
+}
+
+func quickfixAction(doc *morpheus.Document, data unknownIdentifierData, suggestion morpheus.Suggestion, diagnostic protocol.Diagnostic) protocol.CodeAction {
+	kind := protocol.CodeActionKindQuickFix
+	edit := protocol.TextEdit{
+		Range:   byteRangeToRange(doc, data.StartByte, data.EndByte),
+		NewText: suggestion.Name,
+	}
+	return protocol.CodeAction{
+		Title: fmt.Sprintf("Replace %q with %q", data.Name, suggestion.Name),
+		Kind:  &kind,
+		Diagnostics: []protocol.Diagnostic{diagnostic},
+		Edit: &protocol.WorkspaceEdit{
+			Changes: map[string][]protocol.TextEdit{
+				data.URI: {edit},
+			},
+		},
+	}
+}
+
+func severityPtr(s protocol.DiagnosticSeverity) *protocol.DiagnosticSeverity { return &s }
@@ -0,0 +1,49 @@
+package lsp
+
+import (
+	"fmt"
+
+	"github.com/tliron/glsp"
+	protocol "github.com/tliron/glsp/protocol_3_16"
+
+	"github.com/Feho/mohaa-lsp/internal/morpheus"
+)
+
+// TextDocumentDidOpen parses a newly opened script and stores it as the
+// Document every other provider (DocumentLink, CodeAction, ...) reads from.
+func (s *Server) TextDocumentDidOpen(context *glsp.Context, params *protocol.DidOpenTextDocumentParams) error {
+	doc, err := morpheus.NewDocument(params.TextDocument.URI, []byte(params.TextDocument.Text))
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", params.TextDocument.URI, err)
+	}
+	s.documents[params.TextDocument.URI] = doc
+	return nil
+}
+
+// TextDocumentDidChange re-parses the document on every full-content sync
+// (we advertise TextDocumentSyncKindFull, so ContentChanges always holds a
+// single whole-document event).
+func (s *Server) TextDocumentDidChange(context *glsp.Context, params *protocol.DidChangeTextDocumentParams) error {
+	doc, ok := s.documents[params.TextDocument.URI]
+	if !ok {
+		doc = &morpheus.Document{URI: params.TextDocument.URI}
+	}
+	for _, change := range params.ContentChanges {
+		full, ok := change.(protocol.TextDocumentContentChangeEventWhole)
+		if !ok {
+			continue
+		}
+		if _, err := morpheus.Reparse(doc, []byte(full.Text)); err != nil {
+			return fmt.Errorf("reparsing %s: %w", params.TextDocument.URI, err)
+		}
+	}
+	s.documents[params.TextDocument.URI] = doc
+	return nil
+}
+
+// TextDocumentDidClose drops the document; nothing else in the session
+// needs it once the client stops editing it.
+func (s *Server) TextDocumentDidClose(context *glsp.Context, params *protocol.DidCloseTextDocumentParams) error {
+	delete(s.documents, params.TextDocument.URI)
+	return nil
+}
@@ -0,0 +1,178 @@
+package morpheus
+
+// Builtins is the bundled catalogue of global commands, events and TIKI
+// script builtins that ship with MOHAA, used as a fallback candidate set
+// when an identifier doesn't resolve to a local or script-scoped symbol.
+// It is intentionally not exhaustive of every TIKI build's additions - mods
+// that register their own commands will still see "unknown identifier" on
+// those until they're added here - but it covers the common core every
+// script touches, split by category below so it's easy to extend.
+var Builtins = concat(
+	entityCommands,
+	entityAccessors,
+	flowControl,
+	waitEvents,
+	soundCommands,
+	mathAndStringBuiltins,
+	globalObjects,
+)
+
+// entityCommands set state on an entity: position, physics, appearance.
+var entityCommands = []string{
+	"setorigin",
+	"setangles",
+	"setsize",
+	"setmins",
+	"setmaxs",
+	"setmodel",
+	"setmodelscale",
+	"setvelocity",
+	"setavelocity",
+	"setthink",
+	"setnextthink",
+	"setmovetype",
+	"setmovedir",
+	"setdamage",
+	"setdamagetype",
+	"sethealth",
+	"setmaxhealth",
+	"setskin",
+	"setshader",
+	"setviewangles",
+	"settargetname",
+	"setspawnflags",
+	"takedamage",
+	"trigger",
+	"takeweapon",
+	"takeitem",
+	"giveweapon",
+	"giveitem",
+	"giveammo",
+	"spawn",
+	"spawngroup",
+	"remove",
+	"removeweapon",
+	"attach",
+	"detach",
+	"attachoffset",
+	"hide",
+	"show",
+	"usebonecontroller",
+	"useboundingbox",
+}
+
+// entityAccessors return information about an entity without mutating it.
+var entityAccessors = []string{
+	"getorigin",
+	"getangles",
+	"getvelocity",
+	"gethealth",
+	"getmodel",
+	"getowner",
+	"gettargetname",
+	"getentarray",
+	"getboundingbox",
+	"isalive",
+	"isplayer",
+	"isspectator",
+	"istouching",
+	"visible",
+	"distance",
+	"distance2d",
+}
+
+// flowControl are the statement-level keywords that drive thread/script
+// execution.
+var flowControl = []string{
+	"thread",
+	"waitthread",
+	"waitthreadendon",
+	"exec",
+	"execgame",
+	"end",
+	"return",
+	"terminate",
+	"pause",
+	"wait",
+	"waitframe",
+	"if",
+	"else",
+	"while",
+	"for",
+	"switch",
+	"case",
+	"default",
+	"break",
+	"continue",
+	"local",
+}
+
+// waitEvents cover the `notify`/`waittill` family used for event signalling
+// between threads.
+var waitEvents = []string{
+	"notify",
+	"notifyserver",
+	"waittill",
+	"waittillmatch",
+	"waittillframeend",
+	"delaythrow",
+	"endon",
+	"registerevent",
+	"unregisterevent",
+}
+
+// soundCommands play or stop sounds and dialogue.
+var soundCommands = []string{
+	"playsound",
+	"stopsound",
+	"playloopsound",
+	"stoploopsound",
+	"playdialog",
+	"stopdialog",
+	"setvolume",
+}
+
+// mathAndStringBuiltins are pure helper functions available in expressions.
+var mathAndStringBuiltins = []string{
+	"abs",
+	"min",
+	"max",
+	"clamp",
+	"randomint",
+	"randomfloat",
+	"sin",
+	"cos",
+	"sqrt",
+	"vectortoangles",
+	"anglestoforward",
+	"anglestoup",
+	"anglestoright",
+	"strlen",
+	"strcat",
+	"tolower",
+	"toupper",
+}
+
+// globalObjects are the always-in-scope references scripts use to reach the
+// rest of the game state.
+var globalObjects = []string{
+	"level",
+	"game",
+	"world",
+	"self",
+	"owner",
+	"parm",
+	"player",
+	"group",
+}
+
+// concat flattens a list of string slices into one, preserving order. It
+// exists so Builtins can be assembled from the per-category lists above
+// without an extra append chain at the call site.
+func concat(groups ...[]string) []string {
+	var all []string
+	for _, g := range groups {
+		all = append(all, g...)
+	}
+	return all
+}
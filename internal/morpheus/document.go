@@ -0,0 +1,71 @@
+// Package morpheus holds grammar-aware helpers shared by the LSP providers:
+// parsed document state, tree-walking utilities and the builtins catalogue.
+package morpheus
+
+import (
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// Document is a parsed Morpheus script: the tree-sitter tree kept in sync
+// with the client's in-memory buffer, plus the raw source it was parsed
+// from. Providers operate on a Document rather than re-parsing on every
+// request.
+type Document struct {
+	URI    string
+	Source []byte
+	Tree   *sitter.Tree
+}
+
+// Root returns the tree's root node, or nil if the document failed to parse.
+func (d *Document) Root() *sitter.Node {
+	if d.Tree == nil {
+		return nil
+	}
+	return d.Tree.RootNode()
+}
+
+// Text returns the source bytes spanned by node.
+func (d *Document) Text(node *sitter.Node) string {
+	return string(d.Source[node.StartByte():node.EndByte()])
+}
+
+// UTF16Position converts a byte offset into the UTF-16 line/column pair the
+// LSP wire protocol expects. Morpheus scripts are ASCII almost everywhere,
+// but map files occasionally carry non-ASCII strings in comments, so we
+// can't shortcut this with a byte-offset assumption.
+func (d *Document) UTF16Position(offset uint) (line, character uint32) {
+	var l uint32
+	lineStart := 0
+	for i := 0; i < int(offset) && i < len(d.Source); i++ {
+		if d.Source[i] == '\n' {
+			l++
+			lineStart = i + 1
+		}
+	}
+	col := utf16Len(d.Source[lineStart:offset])
+	return l, col
+}
+
+// utf16Len reports the number of UTF-16 code units needed to encode b,
+// which is assumed to be valid UTF-8.
+func utf16Len(b []byte) uint32 {
+	var n uint32
+	for i := 0; i < len(b); {
+		c := b[i]
+		switch {
+		case c < 0x80:
+			i++
+			n++
+		case c < 0xE0:
+			i += 2
+			n++
+		case c < 0xF0:
+			i += 3
+			n++
+		default:
+			i += 4
+			n += 2 // surrogate pair
+		}
+	}
+	return n
+}
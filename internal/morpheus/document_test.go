@@ -0,0 +1,26 @@
+package morpheus
+
+import "testing"
+
+func TestUTF16Position(t *testing.T) {
+	doc := &Document{Source: []byte("a\nbc\n")}
+
+	line, char := doc.UTF16Position(0)
+	if line != 0 || char != 0 {
+		t.Fatalf("UTF16Position(0) = (%d, %d), want (0, 0)", line, char)
+	}
+
+	line, char = doc.UTF16Position(3)
+	if line != 1 || char != 1 {
+		t.Fatalf("UTF16Position(3) = (%d, %d), want (1, 1)", line, char)
+	}
+}
+
+func TestUTF16PositionSurrogatePair(t *testing.T) {
+	doc := &Document{Source: []byte("\xF0\x9F\x98\x80x")} // U+1F600 GRINNING FACE, then "x"
+
+	_, char := doc.UTF16Position(uint(len(doc.Source)))
+	if char != 3 {
+		t.Fatalf("UTF16Position after surrogate pair = %d, want 3", char)
+	}
+}
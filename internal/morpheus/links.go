@@ -0,0 +1,104 @@
+package morpheus
+
+import (
+	"path"
+	"strings"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// directiveQuery captures the path literal of every #include, exec and
+// thread level_script reference in a script, so callers don't need to know
+// the grammar's node layout.
+const directiveQuery = `
+(include_directive path: (string_literal) @path)
+(exec_statement path: (string_literal) @path)
+(thread_statement target: (string_literal) @path (#match? @path "::"))
+`
+
+// Reference is a single script path reference found in a Document, with its
+// byte range (so callers can map it to whatever position encoding they use)
+// and the literal text as written in the source, quotes stripped.
+type Reference struct {
+	StartByte, EndByte uint
+	Path               string
+}
+
+// FindReferences walks doc's parse tree and returns every #include/exec/
+// thread level_script path literal it finds, in document order.
+func FindReferences(doc *Document) ([]Reference, error) {
+	root := doc.Root()
+	if root == nil {
+		return nil, nil
+	}
+
+	query, err := sitter.NewQuery([]byte(directiveQuery), Language())
+	if err != nil {
+		return nil, err
+	}
+	defer query.Close()
+
+	cursor := sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	var refs []Reference
+	matches := cursor.Captures(query, root, doc.Source)
+	for {
+		match, captureIndex := matches.Next()
+		if match == nil {
+			break
+		}
+		capture := match.Captures[captureIndex]
+		raw := doc.Text(&capture.Node)
+		refs = append(refs, Reference{
+			StartByte: uint(capture.Node.StartByte()),
+			EndByte:   uint(capture.Node.EndByte()),
+			Path:      strings.Trim(raw, `"`),
+		})
+	}
+	return refs, nil
+}
+
+// Resolve locates the script a reference points to by searching it, in
+// order, against each configured root (e.g. "maps/", "global/"). It returns
+// the resolved workspace-relative path and true, or "" and false if none of
+// the roots contain a matching file.
+func Resolve(ref string, roots []string, exists func(string) bool) (string, bool) {
+	script, _ := splitThreadTarget(ref)
+	if !strings.HasSuffix(script, ".scr") && !strings.HasSuffix(script, ".con") {
+		script += ".scr"
+	}
+	for _, root := range roots {
+		candidate := path.Join(root, script)
+		if !withinRoot(root, candidate) {
+			continue
+		}
+		if exists(candidate) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// withinRoot reports whether candidate (already path.Join'd from root) is
+// still rooted under root, rejecting references like "../../etc/passwd"
+// that path.Join would otherwise happily clean into a path outside root.
+func withinRoot(root, candidate string) bool {
+	root = path.Clean(root)
+	candidate = path.Clean(candidate)
+	if root == "." {
+		return candidate != ".." && !strings.HasPrefix(candidate, "../")
+	}
+	return candidate == root || strings.HasPrefix(candidate, root+"/")
+}
+
+// splitThreadTarget splits a thread_statement target of the form
+// "script::label" into the script path and the label (a function name
+// inside that script, not a path segment). A reference with no "::" is
+// returned unchanged, with an empty label.
+func splitThreadTarget(ref string) (script, label string) {
+	if i := strings.Index(ref, "::"); i >= 0 {
+		return ref[:i], ref[i+2:]
+	}
+	return ref, ""
+}
@@ -0,0 +1,69 @@
+package morpheus
+
+import "testing"
+
+func existsAmong(paths ...string) func(string) bool {
+	set := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		set[p] = true
+	}
+	return func(p string) bool { return set[p] }
+}
+
+func TestResolveAppendsExtensionAndSearchesRootsInOrder(t *testing.T) {
+	exists := existsAmong("global/util.scr")
+	got, ok := Resolve("util", []string{"maps", "global"}, exists)
+	if !ok || got != "global/util.scr" {
+		t.Fatalf("Resolve(util) = (%q, %v), want (global/util.scr, true)", got, ok)
+	}
+}
+
+func TestResolveLeavesExplicitExtensionAlone(t *testing.T) {
+	exists := existsAmong("global/util.con")
+	got, ok := Resolve("util.con", []string{"global"}, exists)
+	if !ok || got != "global/util.con" {
+		t.Fatalf("Resolve(util.con) = (%q, %v), want (global/util.con, true)", got, ok)
+	}
+}
+
+func TestResolveUnknownReturnsFalse(t *testing.T) {
+	if _, ok := Resolve("missing", []string{"global"}, existsAmong()); ok {
+		t.Fatalf("Resolve(missing) = ok, want not found")
+	}
+}
+
+func TestResolveSplitsThreadTargetLabel(t *testing.T) {
+	exists := existsAmong("maps/mp_obj_dest.scr")
+	got, ok := Resolve("mp_obj_dest::main", []string{"maps"}, exists)
+	if !ok || got != "maps/mp_obj_dest.scr" {
+		t.Fatalf("Resolve(mp_obj_dest::main) = (%q, %v), want (maps/mp_obj_dest.scr, true)", got, ok)
+	}
+}
+
+func TestResolveRejectsPathTraversalOutsideRoot(t *testing.T) {
+	// exists would match if Resolve ever asked about it - it must not.
+	exists := existsAmong("../../../etc/passwd.scr", "etc/passwd.scr")
+	if _, ok := Resolve("../../../../etc/passwd", []string{"maps"}, exists); ok {
+		t.Fatalf("Resolve(../../../../etc/passwd) = ok, want rejected as outside root")
+	}
+}
+
+func TestResolveAllowsDotRoot(t *testing.T) {
+	exists := existsAmong("util.scr")
+	got, ok := Resolve("util", []string{"."}, exists)
+	if !ok || got != "util.scr" {
+		t.Fatalf("Resolve(util) with root \".\" = (%q, %v), want (util.scr, true)", got, ok)
+	}
+}
+
+func TestSplitThreadTarget(t *testing.T) {
+	script, label := splitThreadTarget("mp_obj_dest::main")
+	if script != "mp_obj_dest" || label != "main" {
+		t.Fatalf("splitThreadTarget = (%q, %q), want (mp_obj_dest, main)", script, label)
+	}
+
+	script, label = splitThreadTarget("util")
+	if script != "util" || label != "" {
+		t.Fatalf("splitThreadTarget(util) = (%q, %q), want (util, \"\")", script, label)
+	}
+}
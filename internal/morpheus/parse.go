@@ -0,0 +1,52 @@
+package morpheus
+
+import (
+	"sync"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+
+	grammar "github.com/tree-sitter/tree-sitter-morpheus/bindings/go"
+)
+
+var (
+	languageOnce sync.Once
+	language     *sitter.Language
+)
+
+// Language returns the shared Morpheus sitter.Language, loading it from the
+// grammar's cgo binding on first use.
+func Language() *sitter.Language {
+	languageOnce.Do(func() {
+		language = sitter.NewLanguage(grammar.Language())
+	})
+	return language
+}
+
+// NewDocument parses source with the Morpheus grammar and returns the
+// resulting Document. The parser is created fresh per call; callers that
+// reparse frequently (didChange) should prefer Reparse so tree-sitter can
+// reuse the previous tree for incremental parsing.
+func NewDocument(uri string, source []byte) (*Document, error) {
+	return Reparse(&Document{URI: uri}, source)
+}
+
+// Reparse parses source into doc, reusing doc.Tree as tree-sitter's
+// incremental-parsing seed when one is already present, and returns doc with
+// its Source and Tree updated.
+func Reparse(doc *Document, source []byte) (*Document, error) {
+	parser := sitter.NewParser()
+	defer parser.Close()
+
+	if err := parser.SetLanguage(Language()); err != nil {
+		return nil, err
+	}
+
+	tree := parser.Parse(source, doc.Tree)
+	if doc.Tree != nil {
+		doc.Tree.Close()
+	}
+
+	doc.Source = source
+	doc.Tree = tree
+	return doc, nil
+}
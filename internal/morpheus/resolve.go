@@ -0,0 +1,67 @@
+package morpheus
+
+import (
+	"strings"
+
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// identifierQuery captures every bare identifier used in a command or call
+// position - the places a misspelled builtin or function name shows up.
+const identifierQuery = `
+(command_statement command: (identifier) @name)
+(call_expression function: (identifier) @name)
+`
+
+// Identifier is a single identifier use found in a Document, with the byte
+// range it spans.
+type Identifier struct {
+	StartByte, EndByte uint
+	Name               string
+}
+
+// FindUnresolvedIdentifiers returns every identifier use in doc that isn't
+// in known (typically the document's own symbols plus Builtins), i.e. every
+// candidate for a "did you mean" diagnostic.
+func FindUnresolvedIdentifiers(doc *Document, known []string) ([]Identifier, error) {
+	root := doc.Root()
+	if root == nil {
+		return nil, nil
+	}
+
+	query, err := sitter.NewQuery([]byte(identifierQuery), Language())
+	if err != nil {
+		return nil, err
+	}
+	defer query.Close()
+
+	cursor := sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	// MOHAA/TIKI builtins and identifiers are case-insensitive, matching the
+	// case folding Suggest already does when ranking candidates.
+	knownSet := make(map[string]bool, len(known))
+	for _, name := range known {
+		knownSet[strings.ToLower(name)] = true
+	}
+
+	var unresolved []Identifier
+	matches := cursor.Captures(query, root, doc.Source)
+	for {
+		match, captureIndex := matches.Next()
+		if match == nil {
+			break
+		}
+		node := &match.Captures[captureIndex].Node
+		name := doc.Text(node)
+		if knownSet[strings.ToLower(name)] {
+			continue
+		}
+		unresolved = append(unresolved, Identifier{
+			StartByte: uint(node.StartByte()),
+			EndByte:   uint(node.EndByte()),
+			Name:      name,
+		})
+	}
+	return unresolved, nil
+}
@@ -0,0 +1,182 @@
+package morpheus
+
+import (
+	"sort"
+	"strings"
+)
+
+// MaxSuggestions is the number of "did you mean" candidates surfaced in a
+// diagnostic message and offered as individual code actions.
+const MaxSuggestions = 3
+
+// Suggestion is a single "did you mean" candidate for an unresolved name.
+type Suggestion struct {
+	Name     string
+	Distance int
+}
+
+// Suggest returns up to MaxSuggestions candidates for an unresolved
+// identifier name, drawn from candidates (typically the document's own
+// symbols plus Builtins), ordered by edit distance and then by the tie-break
+// rules in less.
+//
+// A candidate is only considered if a cheap prefix/suffix check makes it
+// plausible, so a large candidate set doesn't force a full edit-distance
+// pass over every name.
+func Suggest(name string, candidates []string) []Suggestion {
+	threshold := maxEditDistance(name)
+	lowerName := strings.ToLower(name)
+
+	var matches []Suggestion
+	seen := make(map[string]bool)
+	for _, candidate := range candidates {
+		if candidate == name || seen[candidate] {
+			continue
+		}
+		seen[candidate] = true
+		if !plausible(lowerName, strings.ToLower(candidate), threshold) {
+			continue
+		}
+		if d := boundedDamerauLevenshtein(lowerName, strings.ToLower(candidate), threshold); d >= 0 {
+			matches = append(matches, Suggestion{Name: candidate, Distance: d})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return less(name, matches[i], matches[j])
+	})
+	if len(matches) > MaxSuggestions {
+		matches = matches[:MaxSuggestions]
+	}
+	return matches
+}
+
+// maxEditDistance is the early-exit threshold: candidates further than this
+// from name are never worth surfacing.
+func maxEditDistance(name string) int {
+	t := len(name) / 4
+	if t < 1 {
+		t = 1
+	}
+	return t
+}
+
+// plausible is a cheap pre-filter: the candidate's length must be within
+// threshold of name's, and the two must share at least their first or their
+// last character, otherwise the full edit-distance computation is skipped.
+func plausible(name, candidate string, threshold int) bool {
+	if abs(len(name)-len(candidate)) > threshold {
+		return false
+	}
+	return commonPrefixLen(name, candidate) > 0 || commonSuffixLen(name, candidate) > 0
+}
+
+func commonPrefixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+func commonSuffixLen(a, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[len(a)-1-n] == b[len(b)-1-n] {
+		n++
+	}
+	return n
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// boundedDamerauLevenshtein computes the Damerau-Levenshtein distance
+// between a and b, bailing out early (returning -1) if it's certain to
+// exceed threshold: it stops as soon as every entry of a row is above
+// threshold. Each row is computed in full, so a candidate that is not
+// rejected early still costs O(len(a)*len(b)).
+func boundedDamerauLevenshtein(a, b string, threshold int) int {
+	la, lb := len(a), len(b)
+	if abs(la-lb) > threshold {
+		return -1
+	}
+
+	// d[i][j] holds the distance between a[:i] and b[:j].
+	d := make([][]int, la+1)
+	for i := range d {
+		d[i] = make([]int, lb+1)
+		d[i][0] = i
+	}
+	for j := 0; j <= lb; j++ {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= la; i++ {
+		rowMin := d[i][0]
+		for j := 1; j <= lb; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			del := d[i-1][j] + 1
+			ins := d[i][j-1] + 1
+			sub := d[i-1][j-1] + cost
+			best := min3(del, ins, sub)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				if transpose := d[i-2][j-2] + cost; transpose < best {
+					best = transpose
+				}
+			}
+			d[i][j] = best
+			if best < rowMin {
+				rowMin = best
+			}
+		}
+		if rowMin > threshold {
+			return -1
+		}
+	}
+
+	if d[la][lb] > threshold {
+		return -1
+	}
+	return d[la][lb]
+}
+
+func min3(a, b, c int) int {
+	m := a
+	if b < m {
+		m = b
+	}
+	if c < m {
+		m = c
+	}
+	return m
+}
+
+// less implements the tie-break order: distance first, then (1) same first
+// letter as the unresolved name, (2) case-insensitive match, (3) shorter
+// candidate.
+func less(name string, a, b Suggestion) bool {
+	if a.Distance != b.Distance {
+		return a.Distance < b.Distance
+	}
+	if sameFirst := firstLetterMatches(name, a.Name); sameFirst != firstLetterMatches(name, b.Name) {
+		return sameFirst
+	}
+	if ciEqual := strings.EqualFold(name, a.Name); ciEqual != strings.EqualFold(name, b.Name) {
+		return ciEqual
+	}
+	return len(a.Name) < len(b.Name)
+}
+
+func firstLetterMatches(name, candidate string) bool {
+	if name == "" || candidate == "" {
+		return false
+	}
+	return strings.EqualFold(name[:1], candidate[:1])
+}
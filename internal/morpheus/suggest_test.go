@@ -0,0 +1,31 @@
+package morpheus
+
+import "testing"
+
+func TestSuggestFindsCloseMatch(t *testing.T) {
+	got := Suggest("setorign", []string{"setorigin", "setangles", "setsize"})
+	if len(got) == 0 || got[0].Name != "setorigin" {
+		t.Fatalf("Suggest(%q) = %+v, want setorigin first", "setorign", got)
+	}
+}
+
+func TestSuggestCapsAtMaxSuggestions(t *testing.T) {
+	candidates := []string{"aaaa", "aaab", "aaac", "aaad", "aaae"}
+	got := Suggest("aaaz", candidates)
+	if len(got) > MaxSuggestions {
+		t.Fatalf("Suggest returned %d candidates, want at most %d", len(got), MaxSuggestions)
+	}
+}
+
+func TestSuggestRejectsFarCandidates(t *testing.T) {
+	got := Suggest("notify", []string{"completelydifferent"})
+	if len(got) != 0 {
+		t.Fatalf("Suggest(%q) = %+v, want no matches", "notify", got)
+	}
+}
+
+func TestBoundedDamerauLevenshteinTransposition(t *testing.T) {
+	if d := boundedDamerauLevenshtein("orgiin", "origin", 2); d != 1 {
+		t.Fatalf("boundedDamerauLevenshtein(orgiin, origin) = %d, want 1", d)
+	}
+}
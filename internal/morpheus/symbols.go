@@ -0,0 +1,44 @@
+package morpheus
+
+import (
+	sitter "github.com/tree-sitter/go-tree-sitter"
+)
+
+// symbolQuery captures every name a script introduces: function
+// declarations and `local.<name>` assignments. It doesn't model block
+// scoping, so a symbol declared in one function is visible as a candidate
+// everywhere else in the same document - acceptable for "did you mean"
+// purposes, where a false-positive candidate just loses a ranking tie.
+const symbolQuery = `
+(function_declaration name: (identifier) @name)
+(local_declaration name: (identifier) @name)
+`
+
+// DocumentSymbolNames returns every function and local name declared in doc,
+// for use as "did you mean" candidates alongside Builtins.
+func DocumentSymbolNames(doc *Document) ([]string, error) {
+	root := doc.Root()
+	if root == nil {
+		return nil, nil
+	}
+
+	query, err := sitter.NewQuery([]byte(symbolQuery), Language())
+	if err != nil {
+		return nil, err
+	}
+	defer query.Close()
+
+	cursor := sitter.NewQueryCursor()
+	defer cursor.Close()
+
+	var names []string
+	matches := cursor.Captures(query, root, doc.Source)
+	for {
+		match, captureIndex := matches.Next()
+		if match == nil {
+			break
+		}
+		names = append(names, doc.Text(&match.Captures[captureIndex].Node))
+	}
+	return names, nil
+}
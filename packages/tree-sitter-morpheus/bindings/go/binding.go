@@ -0,0 +1,13 @@
+package tree_sitter_morpheus
+
+// #cgo CFLAGS: -std=c11 -fPIC
+// #include "../../src/parser.c"
+import "C"
+
+import "unsafe"
+
+// Language returns the tree-sitter Language for this grammar, as an
+// unsafe.Pointer for consumption by tree_sitter.NewLanguage.
+func Language() unsafe.Pointer {
+	return unsafe.Pointer(C.tree_sitter_morpheus())
+}
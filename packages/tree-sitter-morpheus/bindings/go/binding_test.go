@@ -3,8 +3,8 @@ package tree_sitter_morpheus_test
 import (
 	"testing"
 
-	tree_sitter "github.com/smacker/go-tree-sitter"
-	"github.com/tree-sitter/tree-sitter-morpheus"
+	tree_sitter "github.com/tree-sitter/go-tree-sitter"
+	tree_sitter_morpheus "github.com/tree-sitter/tree-sitter-morpheus/bindings/go"
 )
 
 func TestCanLoadGrammar(t *testing.T) {